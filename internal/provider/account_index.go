@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"golang.org/x/sync/singleflight"
+)
+
+// accountIndex is a lazily-populated, shared cache of AWS Organizations
+// accounts keyed by email. It exists because ListAccounts is a full-org scan,
+// and without it every Create/Read on every arc_aws_account resource in a
+// large org would re-walk every page of accounts.
+type accountIndex struct {
+	group singleflight.Group
+
+	mu        sync.RWMutex
+	populated bool
+	byEmail   map[string]orgstypes.Account
+}
+
+func newAccountIndex() *accountIndex {
+	return &accountIndex{byEmail: make(map[string]orgstypes.Account)}
+}
+
+// invalidate marks the index stale so the next lookup re-scans ListAccounts.
+// Callers should invoke this after any Create or Delete that changes the set
+// of accounts in the organization.
+func (idx *accountIndex) invalidate() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.populated = false
+	idx.byEmail = make(map[string]orgstypes.Account)
+}
+
+// findByEmail returns the account with the given email, populating the index
+// from a paginated ListAccounts scan on first use. Concurrent callers share a
+// single in-flight scan via singleflight rather than each issuing their own.
+func (idx *accountIndex) findByEmail(ctx context.Context, c *organizations.Client, email string) (*orgstypes.Account, error) {
+	if account, ok := idx.lookup(email); ok {
+		return account, nil
+	}
+
+	if _, err, _ := idx.group.Do("scan", func() (interface{}, error) {
+		return nil, idx.scan(ctx, c)
+	}); err != nil {
+		return nil, err
+	}
+
+	if account, ok := idx.lookup(email); ok {
+		return account, nil
+	}
+
+	return nil, nil
+}
+
+// lookup returns the cached account for email, and whether the index has been
+// populated at all (a populated index with no match means "definitely absent").
+func (idx *accountIndex) lookup(email string) (*orgstypes.Account, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if !idx.populated {
+		return nil, false
+	}
+
+	account, ok := idx.byEmail[email]
+	if !ok {
+		return nil, true
+	}
+
+	return &account, true
+}
+
+// scan performs the full ListAccounts pagination and replaces the cache. It
+// does nothing if the index is already populated.
+func (idx *accountIndex) scan(ctx context.Context, c *organizations.Client) error {
+	idx.mu.RLock()
+	alreadyPopulated := idx.populated
+	idx.mu.RUnlock()
+
+	if alreadyPopulated {
+		return nil
+	}
+
+	byEmail := make(map[string]orgstypes.Account)
+	paginator := organizations.NewListAccountsPaginator(c, &organizations.ListAccountsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+		for _, a := range out.Accounts {
+			byEmail[aws.ToString(a.Email)] = a
+		}
+	}
+
+	idx.mu.Lock()
+	idx.byEmail = byEmail
+	idx.populated = true
+	idx.mu.Unlock()
+
+	return nil
+}
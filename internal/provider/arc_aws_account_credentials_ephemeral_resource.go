@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultGovernedAccountRoleName is the role AWS Organizations creates in
+// every member account for access from the management account.
+const defaultGovernedAccountRoleName = "OrganizationAccountAccessRole"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &GovernedAccountEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &GovernedAccountEphemeralResource{}
+
+func NewGovernedAccountEphemeralResource() ephemeral.EphemeralResource {
+	return &GovernedAccountEphemeralResource{}
+}
+
+// GovernedAccountEphemeralResource defines the ephemeral resource implementation.
+type GovernedAccountEphemeralResource struct {
+	sts *sts.Client
+}
+
+// GovernedAccountEphemeralResourceModel describes the ephemeral resource data model.
+type GovernedAccountEphemeralResourceModel struct {
+	AccountID       types.String `tfsdk:"account_id"`
+	RoleName        types.String `tfsdk:"role_name"`
+	SessionName     types.String `tfsdk:"session_name"`
+	Duration        types.String `tfsdk:"duration"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	SessionToken    types.String `tfsdk:"session_token"`
+	Expiration      types.String `tfsdk:"expiration"`
+}
+
+func (e *GovernedAccountEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_account_credentials"
+}
+
+func (e *GovernedAccountEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assumes a role into an AWS Organizations member account and vends short-lived credentials without persisting them to state.",
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the member account to assume a role into, e.g. the `account_id` of an `architect_aws_account`.",
+				Required:            true,
+			},
+			"role_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role to assume in the member account. Defaults to `OrganizationAccountAccessRole`, the role AWS Organizations creates automatically.",
+				Optional:            true,
+			},
+			"session_name": schema.StringAttribute{
+				MarkdownDescription: "The session name to use when assuming the role. Defaults to `architect-governed-account`.",
+				Optional:            true,
+			},
+			"duration": schema.StringAttribute{
+				MarkdownDescription: "The duration the assumed role credentials are valid for, e.g. `1h`. Defaults to one hour.",
+				Optional:            true,
+			},
+			"access_key_id": schema.StringAttribute{
+				MarkdownDescription: "The access key ID of the assumed role credentials.",
+				Computed:            true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				MarkdownDescription: "The secret access key of the assumed role credentials.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"session_token": schema.StringAttribute{
+				MarkdownDescription: "The session token of the assumed role credentials.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expiration": schema.StringAttribute{
+				MarkdownDescription: "The RFC3339 timestamp at which the credentials expire.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (e *GovernedAccountEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if d, ok := req.ProviderData.(*ArchitectResourceData); ok && d != nil {
+		e.sts = d.STS
+		tflog.Debug(ctx, "configured GovernedAccountEphemeralResource with *ArchitectResourceData")
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Unexpected Provider Configuration",
+		fmt.Sprintf("Expected *ArchitectResourceData, got %T", req.ProviderData),
+	)
+}
+
+func (e *GovernedAccountEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data GovernedAccountEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := data.RoleName.ValueString()
+	if roleName == "" {
+		roleName = defaultGovernedAccountRoleName
+	}
+
+	sessionName := data.SessionName.ValueString()
+	if sessionName == "" {
+		sessionName = "architect-governed-account"
+	}
+
+	input := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(fmt.Sprintf("arn:aws:iam::%s:role/%s", data.AccountID.ValueString(), roleName)),
+		RoleSessionName: aws.String(sessionName),
+	}
+
+	if v := data.Duration.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid duration", err.Error())
+			return
+		}
+
+		input.DurationSeconds = aws.Int32(int32(d.Seconds()))
+	}
+
+	out, err := e.sts.AssumeRole(ctx, input)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue assuming role into governed account", err.Error())
+		return
+	}
+
+	data.AccessKeyID = types.StringValue(aws.ToString(out.Credentials.AccessKeyId))
+	data.SecretAccessKey = types.StringValue(aws.ToString(out.Credentials.SecretAccessKey))
+	data.SessionToken = types.StringValue(aws.ToString(out.Credentials.SessionToken))
+	data.Expiration = types.StringValue(out.Credentials.Expiration.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -5,15 +5,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	orgstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	timeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	validators "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,19 +34,31 @@ func NewAccountResource() resource.Resource {
 
 // AccountResource defines the resource implementation.
 type AccountResource struct {
-	orgs *organizations.Client
+	orgs         *organizations.Client
+	defaultTags  map[string]string
+	accountIndex *accountIndex
 }
 
 // AccountResourceModel describes the resource data model.
 type AccountResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	AccountID    types.String `tfsdk:"account_id"`
-	ClosedUnitID types.String `tfsdk:"closed_unit_id"`
-	UnitID       types.String `tfsdk:"unit_id"`
-	Email        types.String `tfsdk:"email"`
-	Name         types.String `tfsdk:"name"`
+	ID           types.String   `tfsdk:"id"`
+	AccountID    types.String   `tfsdk:"account_id"`
+	ClosedUnitID types.String   `tfsdk:"closed_unit_id"`
+	UnitID       types.String   `tfsdk:"unit_id"`
+	Email        types.String   `tfsdk:"email"`
+	Name         types.String   `tfsdk:"name"`
+	Tags         types.Map      `tfsdk:"tags"`
+	TagsAll      types.Map      `tfsdk:"tags_all"`
+	DeletionMode types.String   `tfsdk:"deletion_mode"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
+const (
+	deletionModeMoveToClosedOU = "move_to_closed_ou"
+	deletionModeClose          = "close"
+	deletionModeRemoveFromOrg  = "remove_from_organization"
+)
+
 func (r *AccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_aws_account"
 }
@@ -53,7 +71,12 @@ func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed: true,
 			},
 			"account_id": schema.StringAttribute{
-				Computed: true,
+				MarkdownDescription: "The AWS account ID. Set this to import an existing account by ID instead of looking it up by `email`, skipping the org-wide account scan.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"closed_unit_id": schema.StringAttribute{
 				MarkdownDescription: "closed unit id",
@@ -75,6 +98,33 @@ func (r *AccountResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:            true,
 				Optional:            false,
 			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "A map of tags to assign to the account.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "A map of all tags assigned to the account, including those inherited from the provider `default_tags` configuration block.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"deletion_mode": schema.StringAttribute{
+				MarkdownDescription: "Controls what Delete does to the account: `move_to_closed_ou` (default) moves it to `closed_unit_id`; `close` calls CloseAccount and waits for it to reach `PENDING_CLOSURE`/`SUSPENDED`; `remove_from_organization` calls RemoveAccountFromOrganization.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(deletionModeMoveToClosedOU),
+				Validators: []validator.String{
+					validators.OneOf(deletionModeMoveToClosedOU, deletionModeClose, deletionModeRemoveFromOrg),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -84,15 +134,17 @@ func (r *AccountResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	if c, ok := req.ProviderData.(*organizations.Client); ok && c != nil {
-		r.orgs = c
-		tflog.Debug(ctx, "configured AccountResource with *organizations.Client (direct)")
+	if d, ok := req.ProviderData.(*ArchitectResourceData); ok && d != nil {
+		r.orgs = d.Orgs
+		r.defaultTags = d.DefaultTags
+		r.accountIndex = d.AccountIndex
+		tflog.Debug(ctx, "configured AccountResource with *ArchitectResourceData")
 		return
 	}
 
 	resp.Diagnostics.AddError(
 		"Unexpected Provider Configuration",
-		fmt.Sprintf("Expected *organizations.Client, an orgsGetter, or a wrapper with Orgs/Organizations *organizations.Client; got %T", req.ProviderData),
+		fmt.Sprintf("Expected *ArchitectResourceData, got %T", req.ProviderData),
 	)
 }
 
@@ -105,20 +157,44 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOrgWaitLimit)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	email := plan.Email.ValueString()
 	closedUnitID := plan.ClosedUnitID.ValueString()
 	name := plan.Name.ValueString()
 	unitID := plan.UnitID.ValueString()
 
-	account, findAccError := findAccountByEmail(ctx, r.orgs, email)
+	var account *orgstypes.Account
 
-	if findAccError != nil {
-		resp.Diagnostics.AddError("Issue finding account", findAccError.Error())
-		return
+	if accountID := plan.AccountID.ValueString(); accountID != "" {
+		descOut, err := r.orgs.DescribeAccount(ctx, &organizations.DescribeAccountInput{
+			AccountId: aws.String(accountID),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Issue describing account", err.Error())
+			return
+		}
+
+		account = descOut.Account
+	} else {
+		found, findAccError := r.accountIndex.findByEmail(ctx, r.orgs, email)
+
+		if findAccError != nil {
+			resp.Diagnostics.AddError("Issue finding account", findAccError.Error())
+			return
+		}
+
+		account = found
 	}
 
 	if account != nil {
-		if account.Status == "SUSPENDED" {
+		if account.Status == orgstypes.AccountStatusSuspended {
 			resp.Diagnostics.AddError("An account was found, however it is pending closure.", "Please reopen the account or wait for aws to delete it.")
 			return
 		}
@@ -137,11 +213,15 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
-		if closedUnitID == *out.Parents[0].Id {
-			_, moveAccountError := r.orgs.MoveAccount(ctx, &organizations.MoveAccountInput{
-				AccountId:           aws.String(*account.Id),
-				SourceParentId:      aws.String(closedUnitID),
-				DestinationParentId: aws.String(unitID),
+		switch *out.Parents[0].Id {
+		case closedUnitID:
+			moveAccountError := retryOrgOperation(ctx, createTimeout, func() error {
+				_, err := r.orgs.MoveAccount(ctx, &organizations.MoveAccountInput{
+					AccountId:           aws.String(*account.Id),
+					SourceParentId:      aws.String(closedUnitID),
+					DestinationParentId: aws.String(unitID),
+				})
+				return err
 			})
 
 			if moveAccountError != nil {
@@ -150,8 +230,12 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 			}
 
 			plan.AccountID = types.StringValue(*account.Id)
-		} else {
-			resp.Diagnostics.AddWarning("An account was already found in the same organizational unit.", "If this account was not part of a timeout issue you may have duplicate account emails.")
+		case unitID:
+			// Already adopted into the target unit, e.g. via account_id import
+			// rather than a fresh CreateAccount. Nothing to move.
+			plan.AccountID = types.StringValue(*account.Id)
+		default:
+			resp.Diagnostics.AddWarning("An account was found outside of both the target and closed organizational units.", "If this account was not part of a timeout issue you may have duplicate account emails.")
 			return
 		}
 	} else {
@@ -165,18 +249,23 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 			return
 		}
 
-		result := waitForAccountCreation(ctx, r.orgs, *newAccount.CreateAccountStatus.Id)
+		r.accountIndex.invalidate()
+
+		accountID, waitErr := waitForAccountCreation(ctx, r.orgs, *newAccount.CreateAccountStatus.Id, createTimeout)
 
-		if !result {
-			resp.Diagnostics.AddError("Error while waiting for account creation.", "Timeout issue. You can retry again.")
+		if waitErr != nil {
+			resp.Diagnostics.AddError("Error while waiting for account creation.", waitErr.Error())
 			return
 		}
 
-		plan.AccountID = types.StringValue(*newAccount.CreateAccountStatus.AccountId)
+		plan.AccountID = types.StringValue(accountID)
 
-		_, moveAccountError := r.orgs.MoveAccount(ctx, &organizations.MoveAccountInput{
-			AccountId:           aws.String(*newAccount.CreateAccountStatus.AccountId),
-			DestinationParentId: aws.String(unitID),
+		moveAccountError := retryOrgOperation(ctx, createTimeout, func() error {
+			_, err := r.orgs.MoveAccount(ctx, &organizations.MoveAccountInput{
+				AccountId:           aws.String(accountID),
+				DestinationParentId: aws.String(unitID),
+			})
+			return err
 		})
 
 		if moveAccountError != nil {
@@ -187,6 +276,40 @@ func (r *AccountResource) Create(ctx context.Context, req resource.CreateRequest
 
 	plan.ID = types.StringValue(fmt.Sprintf("arcorg:%s", plan.AccountID.ValueString()))
 
+	tags, diags := tagsFromMap(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := mergeTags(r.defaultTags, tags)
+
+	if len(tagsAll) > 0 {
+		if err := updateAccountTags(ctx, r.orgs, plan.AccountID.ValueString(), nil, tagsAll); err != nil {
+			resp.Diagnostics.AddError("Issue tagging account", err.Error())
+			return
+		}
+	}
+
+	tagsAllValue, diags := mapFromTags(ctx, tagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.TagsAll = tagsAllValue
+
+	tagsValue, diags := mapFromTags(ctx, filterDefaultTags(tagsAll, r.defaultTags))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Tags = tagsValue
+
 	tflog.Trace(ctx, "created account resource")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -201,20 +324,68 @@ func (r *AccountResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	account, err := findAccountByEmail(ctx, r.orgs, data.Email.ValueString())
+	var account *orgstypes.Account
 
-	if err != nil {
-		resp.Diagnostics.AddError("Issue finding account", err.Error())
-		return
+	if accountID := data.AccountID.ValueString(); accountID != "" {
+		descOut, err := r.orgs.DescribeAccount(ctx, &organizations.DescribeAccountInput{
+			AccountId: aws.String(accountID),
+		})
+
+		if err != nil {
+			var notFound *orgstypes.AccountNotFoundException
+			if errors.As(err, &notFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Issue describing account", err.Error())
+			return
+		}
+
+		account = descOut.Account
+	} else {
+		found, err := r.accountIndex.findByEmail(ctx, r.orgs, data.Email.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Issue finding account", err.Error())
+			return
+		}
+
+		account = found
 	}
 
-	if account == nil {
+	if account == nil || account.Status == orgstypes.AccountStatusSuspended {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	data.AccountID = types.StringPointerValue(account.Id)
-	data.ID = types.StringValue(fmt.Sprintf("arcorg:%s", account.Id))
+	data.ID = types.StringValue(fmt.Sprintf("arcorg:%s", aws.ToString(account.Id)))
+
+	remoteTags, err := listAccountTags(ctx, r.orgs, aws.ToString(account.Id))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue listing account tags", err.Error())
+		return
+	}
+
+	tagsAllValue, diags := mapFromTags(ctx, remoteTags)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = tagsAllValue
+
+	tagsValue, diags := mapFromTags(ctx, filterDefaultTags(remoteTags, r.defaultTags))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Tags = tagsValue
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -231,6 +402,50 @@ func (r *AccountResource) Update(ctx context.Context, req resource.UpdateRequest
 		resp.Diagnostics.AddError("Cannot Modify Account after creation", "Destroy this resource and re-create it")
 		return
 	}
+
+	tags, diags := tagsFromMap(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldTagsAll, diags := tagsFromMap(ctx, state.TagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newTagsAll := mergeTags(r.defaultTags, tags)
+
+	if err := updateAccountTags(ctx, r.orgs, state.AccountID.ValueString(), oldTagsAll, newTagsAll); err != nil {
+		resp.Diagnostics.AddError("Issue updating account tags", err.Error())
+		return
+	}
+
+	plan.AccountID = state.AccountID
+	plan.ID = state.ID
+
+	tagsAllValue, diags := mapFromTags(ctx, newTagsAll)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.TagsAll = tagsAllValue
+
+	tagsValue, diags := mapFromTags(ctx, filterDefaultTags(newTagsAll, r.defaultTags))
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Tags = tagsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -240,61 +455,64 @@ func (r *AccountResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, moveAccountError := r.orgs.MoveAccount(ctx, &organizations.MoveAccountInput{
-		AccountId:           aws.String(state.AccountID.ValueString()),
-		SourceParentId:      aws.String(state.UnitID.ValueString()),
-		DestinationParentId: aws.String(state.ClosedUnitID.ValueString()),
-	})
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOrgWaitLimit)
+	resp.Diagnostics.Append(diags...)
 
-	if moveAccountError != nil {
-		resp.Diagnostics.AddError("Error moving the account", moveAccountError.Error())
+	if resp.Diagnostics.HasError() {
 		return
 	}
-}
 
-func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
+	switch state.DeletionMode.ValueString() {
+	case deletionModeClose:
+		_, err := r.orgs.CloseAccount(ctx, &organizations.CloseAccountInput{
+			AccountId: aws.String(state.AccountID.ValueString()),
+		})
 
-func findAccountByEmail(ctx context.Context, c *organizations.Client, email string) (*orgstypes.Account, error) {
-	var next *string
-	for {
-		out, err := c.ListAccounts(ctx, &organizations.ListAccountsInput{NextToken: next})
 		if err != nil {
-			return nil, err
-		}
-		for _, a := range out.Accounts {
-			if aws.ToString(a.Email) == email {
-				return &a, nil
+			var constraintErr *orgstypes.ConstraintViolationException
+			if errors.As(err, &constraintErr) && constraintErr.Reason == orgstypes.ConstraintViolationExceptionReasonAccountNumberLimitExceeded {
+				resp.Diagnostics.AddError(
+					"Account closure quota exceeded",
+					"AWS Organizations allows closing at most 10% of member accounts in a rolling 30-day window. Wait for the quota to reset and retry, or use a different deletion_mode.",
+				)
+				return
 			}
+			resp.Diagnostics.AddError("Error closing the account", err.Error())
+			return
 		}
-		if out.NextToken == nil {
-			break
-		}
-		next = out.NextToken
-	}
-	return nil, nil
-}
 
-func waitForAccountCreation(ctx context.Context, c *organizations.Client, reqID string) bool {
-	for i := 0; i < 60; i++ {
-		time.Sleep(10 * time.Second)
-		desc, err := c.DescribeCreateAccountStatus(ctx, &organizations.DescribeCreateAccountStatusInput{
-			CreateAccountRequestId: aws.String(reqID),
+		if err := waitForAccountClosure(ctx, r.orgs, state.AccountID.ValueString(), deleteTimeout); err != nil {
+			resp.Diagnostics.AddError("Error while waiting for account closure", err.Error())
+			return
+		}
+	case deletionModeRemoveFromOrg:
+		_, err := r.orgs.RemoveAccountFromOrganization(ctx, &organizations.RemoveAccountFromOrganizationInput{
+			AccountId: aws.String(state.AccountID.ValueString()),
 		})
+
 		if err != nil {
-			continue
-		}
-		st := desc.CreateAccountStatus
-		if st == nil {
-			continue
+			resp.Diagnostics.AddError("Error removing the account from the organization", err.Error())
+			return
 		}
-		switch st.State {
-		case orgstypes.CreateAccountStateSucceeded:
-			return true
-		case orgstypes.CreateAccountStateFailed:
-			return false
+	default:
+		moveAccountError := retryOrgOperation(ctx, deleteTimeout, func() error {
+			_, err := r.orgs.MoveAccount(ctx, &organizations.MoveAccountInput{
+				AccountId:           aws.String(state.AccountID.ValueString()),
+				SourceParentId:      aws.String(state.UnitID.ValueString()),
+				DestinationParentId: aws.String(state.ClosedUnitID.ValueString()),
+			})
+			return err
+		})
+
+		if moveAccountError != nil {
+			resp.Diagnostics.AddError("Error moving the account", moveAccountError.Error())
+			return
 		}
 	}
-	return false
+
+	r.accountIndex.invalidate()
+}
+
+func (r *AccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
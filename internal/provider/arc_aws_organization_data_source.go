@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationDataSource{}
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &OrganizationDataSource{}
+}
+
+// OrganizationDataSource defines the data source implementation.
+type OrganizationDataSource struct {
+	orgs *organizations.Client
+}
+
+// OrganizationDataSourceModel describes the data source data model.
+type OrganizationDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ARN             types.String `tfsdk:"arn"`
+	RootID          types.String `tfsdk:"root_id"`
+	MasterAccountID types.String `tfsdk:"master_account_id"`
+}
+
+func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_organization"
+}
+
+func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up details about the AWS Organization the provider is authenticated into.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization.",
+				Computed:            true,
+			},
+			"arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the organization.",
+				Computed:            true,
+			},
+			"root_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization's root.",
+				Computed:            true,
+			},
+			"master_account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the organization's management account.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*organizations.Client); ok && c != nil {
+		d.orgs = c
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Unexpected Provider Configuration",
+		fmt.Sprintf("Expected *organizations.Client, got %T", req.ProviderData),
+	)
+}
+
+func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	describeOut, err := d.orgs.DescribeOrganization(ctx, &organizations.DescribeOrganizationInput{})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue describing organization", err.Error())
+		return
+	}
+
+	rootsOut, err := d.orgs.ListRoots(ctx, &organizations.ListRootsInput{})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue listing organization roots", err.Error())
+		return
+	}
+
+	if len(rootsOut.Roots) != 1 {
+		resp.Diagnostics.AddError("Unexpected number of organization roots", fmt.Sprintf("expected exactly one root, got %d", len(rootsOut.Roots)))
+		return
+	}
+
+	data.ID = types.StringValue(aws.ToString(describeOut.Organization.Id))
+	data.ARN = types.StringValue(aws.ToString(describeOut.Organization.Arn))
+	data.MasterAccountID = types.StringValue(aws.ToString(describeOut.Organization.MasterAccountId))
+	data.RootID = types.StringValue(aws.ToString(rootsOut.Roots[0].Id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationalUnitDataSource{}
+
+func NewOrganizationalUnitDataSource() datasource.DataSource {
+	return &OrganizationalUnitDataSource{}
+}
+
+// OrganizationalUnitDataSource defines the data source implementation.
+type OrganizationalUnitDataSource struct {
+	orgs *organizations.Client
+}
+
+// OrganizationalUnitDataSourceModel describes the data source data model.
+type OrganizationalUnitDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ARN      types.String `tfsdk:"arn"`
+	Name     types.String `tfsdk:"name"`
+	ParentID types.String `tfsdk:"parent_id"`
+}
+
+func (d *OrganizationalUnitDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_organizational_unit"
+}
+
+func (d *OrganizationalUnitDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an AWS Organizations organizational unit by name under a given parent.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organizational unit.",
+				Computed:            true,
+			},
+			"arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the organizational unit.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organizational unit to look up.",
+				Required:            true,
+			},
+			"parent_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the parent root or organizational unit to search under.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (d *OrganizationalUnitDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if c, ok := req.ProviderData.(*organizations.Client); ok && c != nil {
+		d.orgs = c
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Unexpected Provider Configuration",
+		fmt.Sprintf("Expected *organizations.Client, got %T", req.ProviderData),
+	)
+}
+
+func (d *OrganizationalUnitDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationalUnitDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ou, err := findOrganizationalUnitByName(ctx, d.orgs, data.ParentID.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue listing organizational units", err.Error())
+		return
+	}
+
+	if ou == nil {
+		resp.Diagnostics.AddError("Organizational unit not found", fmt.Sprintf("no organizational unit named %q found under parent %q", data.Name.ValueString(), data.ParentID.ValueString()))
+		return
+	}
+
+	data.ID = types.StringValue(aws.ToString(ou.Id))
+	data.ARN = types.StringValue(aws.ToString(ou.Arn))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationalUnitResource{}
+var _ resource.ResourceWithImportState = &OrganizationalUnitResource{}
+
+func NewOrganizationalUnitResource() resource.Resource {
+	return &OrganizationalUnitResource{}
+}
+
+// OrganizationalUnitResource defines the resource implementation.
+type OrganizationalUnitResource struct {
+	orgs *organizations.Client
+}
+
+// OrganizationalUnitResourceModel describes the resource data model.
+type OrganizationalUnitResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ARN      types.String `tfsdk:"arn"`
+	Name     types.String `tfsdk:"name"`
+	ParentID types.String `tfsdk:"parent_id"`
+}
+
+func (r *OrganizationalUnitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_aws_organizational_unit"
+}
+
+func (r *OrganizationalUnitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an AWS Organizations organizational unit.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"arn": schema.StringAttribute{
+				MarkdownDescription: "The ARN of the organizational unit.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organizational unit.",
+				Required:            true,
+			},
+			"parent_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the parent root or organizational unit.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationalUnitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if d, ok := req.ProviderData.(*ArchitectResourceData); ok && d != nil {
+		r.orgs = d.Orgs
+		tflog.Debug(ctx, "configured OrganizationalUnitResource with *ArchitectResourceData")
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Unexpected Provider Configuration",
+		fmt.Sprintf("Expected *ArchitectResourceData, got %T", req.ProviderData),
+	)
+}
+
+func (r *OrganizationalUnitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var out *organizations.CreateOrganizationalUnitOutput
+
+	err := retryOrgOperation(ctx, defaultOrgWaitLimit, func() error {
+		var createErr error
+		out, createErr = r.orgs.CreateOrganizationalUnit(ctx, &organizations.CreateOrganizationalUnitInput{
+			Name:     aws.String(plan.Name.ValueString()),
+			ParentId: aws.String(plan.ParentID.ValueString()),
+		})
+		return createErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue creating organizational unit", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(aws.ToString(out.OrganizationalUnit.Id))
+	plan.ARN = types.StringValue(aws.ToString(out.OrganizationalUnit.Arn))
+
+	tflog.Trace(ctx, "created organizational unit resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationalUnitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.orgs.DescribeOrganizationalUnit(ctx, &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: aws.String(data.ID.ValueString()),
+	})
+
+	if err != nil {
+		var notFound *orgstypes.OrganizationalUnitNotFoundException
+		if errors.As(err, &notFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Issue reading organizational unit", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(aws.ToString(out.OrganizationalUnit.Name))
+	data.ARN = types.StringValue(aws.ToString(out.OrganizationalUnit.Arn))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationalUnitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ParentID != state.ParentID {
+		resp.Diagnostics.AddError("Cannot Modify parent_id after creation", "Destroy this resource and re-create it")
+		return
+	}
+
+	var out *organizations.UpdateOrganizationalUnitOutput
+
+	err := retryOrgOperation(ctx, defaultOrgWaitLimit, func() error {
+		var updateErr error
+		out, updateErr = r.orgs.UpdateOrganizationalUnit(ctx, &organizations.UpdateOrganizationalUnitInput{
+			OrganizationalUnitId: aws.String(state.ID.ValueString()),
+			Name:                 aws.String(plan.Name.ValueString()),
+		})
+		return updateErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue updating organizational unit", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	plan.ARN = types.StringValue(aws.ToString(out.OrganizationalUnit.Arn))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationalUnitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationalUnitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retryOrgOperation(ctx, defaultOrgWaitLimit, func() error {
+		_, deleteErr := r.orgs.DeleteOrganizationalUnit(ctx, &organizations.DeleteOrganizationalUnitInput{
+			OrganizationalUnitId: aws.String(state.ID.ValueString()),
+		})
+		return deleteErr
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Issue deleting organizational unit", err.Error())
+		return
+	}
+}
+
+func (r *OrganizationalUnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findOrganizationalUnitByName looks up an organizational unit by name directly under parentID.
+func findOrganizationalUnitByName(ctx context.Context, c organizations.ListOrganizationalUnitsForParentAPIClient, parentID, name string) (*orgstypes.OrganizationalUnit, error) {
+	paginator := organizations.NewListOrganizationalUnitsForParentPaginator(c, &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentID),
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ou := range out.OrganizationalUnits {
+			if aws.ToString(ou.Name) == name {
+				return &ou, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+// fakeListOUsClient serves ListOrganizationalUnitsForParent from a fixed set
+// of pages, so findOrganizationalUnitByName's pagination can be exercised
+// without a real Organizations API.
+type fakeListOUsClient struct {
+	pages [][]orgstypes.OrganizationalUnit
+	calls int
+}
+
+func (f *fakeListOUsClient) ListOrganizationalUnitsForParent(ctx context.Context, params *organizations.ListOrganizationalUnitsForParentInput, optFns ...func(*organizations.Options)) (*organizations.ListOrganizationalUnitsForParentOutput, error) {
+	page := f.pages[f.calls]
+	f.calls++
+
+	out := &organizations.ListOrganizationalUnitsForParentOutput{
+		OrganizationalUnits: page,
+	}
+
+	if f.calls < len(f.pages) {
+		out.NextToken = aws.String("next")
+	}
+
+	return out, nil
+}
+
+func TestFindOrganizationalUnitByName_FoundOnLaterPage(t *testing.T) {
+	client := &fakeListOUsClient{
+		pages: [][]orgstypes.OrganizationalUnit{
+			{{Id: aws.String("ou-1"), Name: aws.String("dev")}},
+			{{Id: aws.String("ou-2"), Name: aws.String("prod")}},
+		},
+	}
+
+	ou, err := findOrganizationalUnitByName(context.Background(), client, "r-root", "prod")
+
+	if err != nil {
+		t.Fatalf("findOrganizationalUnitByName() error = %v", err)
+	}
+
+	if ou == nil || aws.ToString(ou.Id) != "ou-2" {
+		t.Fatalf("findOrganizationalUnitByName() = %v, want ou-2", ou)
+	}
+
+	if client.calls != 2 {
+		t.Errorf("expected pagination to walk 2 pages, walked %d", client.calls)
+	}
+}
+
+func TestFindOrganizationalUnitByName_NotFound(t *testing.T) {
+	client := &fakeListOUsClient{
+		pages: [][]orgstypes.OrganizationalUnit{
+			{{Id: aws.String("ou-1"), Name: aws.String("dev")}},
+		},
+	}
+
+	ou, err := findOrganizationalUnitByName(context.Background(), client, "r-root", "missing")
+
+	if err != nil {
+		t.Fatalf("findOrganizationalUnitByName() error = %v", err)
+	}
+
+	if ou != nil {
+		t.Fatalf("findOrganizationalUnitByName() = %v, want nil", ou)
+	}
+}
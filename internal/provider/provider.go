@@ -5,14 +5,21 @@ package provider
 
 import (
 	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure ArchitectProvider satisfies various provider interfaces.
@@ -22,10 +29,45 @@ var _ provider.ProviderWithEphemeralResources = &ArchitectProvider{}
 
 // ArchitectProvider defines the provider implementation.
 type ArchitectProvider struct {
-	orgs *organizations.Client
-	version string
+	orgs         *organizations.Client
+	version      string
+	accountIndex *accountIndex
+}
+
+// ArchitectProviderModel describes the provider data model.
+type ArchitectProviderModel struct {
+	Region                 types.String       `tfsdk:"region"`
+	Profile                types.String       `tfsdk:"profile"`
+	AccessKey              types.String       `tfsdk:"access_key"`
+	SecretKey              types.String       `tfsdk:"secret_key"`
+	Token                  types.String       `tfsdk:"token"`
+	SharedCredentialsFiles []types.String     `tfsdk:"shared_credentials_files"`
+	AssumeRole             []AssumeRoleModel  `tfsdk:"assume_role"`
+	DefaultTags            []DefaultTagsModel `tfsdk:"default_tags"`
+}
+
+// DefaultTagsModel describes the `default_tags` nested block.
+type DefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
 }
 
+// ArchitectResourceData is the value handed to resources and ephemeral
+// resources via ResourceData and EphemeralResourceData, bundling the configured Organizations client
+// with provider-level settings resources need, such as default tags.
+type ArchitectResourceData struct {
+	Orgs         *organizations.Client
+	STS          *sts.Client
+	DefaultTags  map[string]string
+	AccountIndex *accountIndex
+}
+
+// AssumeRoleModel describes the `assume_role` nested block.
+type AssumeRoleModel struct {
+	RoleARN     types.String `tfsdk:"role_arn"`
+	SessionName types.String `tfsdk:"session_name"`
+	ExternalID  types.String `tfsdk:"external_id"`
+	Duration    types.String `tfsdk:"duration"`
+}
 
 func (p *ArchitectProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "architect"
@@ -33,35 +75,187 @@ func (p *ArchitectProvider) Metadata(ctx context.Context, req provider.MetadataR
 }
 
 func (p *ArchitectProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
-	resp.Schema = schema.Schema{}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Architect provider manages AWS Organizations resources such as member accounts and organizational units.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The AWS region used for API calls. Falls back to the standard AWS SDK region resolution (env vars, shared config) when unset.",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "The AWS shared config/credentials profile to use.",
+				Optional:            true,
+			},
+			"access_key": schema.StringAttribute{
+				MarkdownDescription: "The AWS access key used for static credentials. Must be used with `secret_key`.",
+				Optional:            true,
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "The AWS secret key used for static credentials. Must be used with `access_key`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The AWS session token used alongside `access_key`/`secret_key` for temporary credentials.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"shared_credentials_files": schema.ListAttribute{
+				MarkdownDescription: "A list of paths to shared credentials files to load in place of the default `~/.aws/credentials`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				MarkdownDescription: "Configuration block with default tags for `architect_aws_account` resources. Other resources of this provider do not take default tags.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							MarkdownDescription: "A map of tags to apply to every `architect_aws_account` resource, merged into each account's `tags_all`.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"assume_role": schema.ListNestedBlock{
+				MarkdownDescription: "Configuration for assuming an IAM role before making AWS Organizations API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"role_arn": schema.StringAttribute{
+							MarkdownDescription: "The ARN of the IAM role to assume.",
+							Required:            true,
+						},
+						"session_name": schema.StringAttribute{
+							MarkdownDescription: "The session name to use when assuming the role.",
+							Optional:            true,
+						},
+						"external_id": schema.StringAttribute{
+							MarkdownDescription: "The external ID to use when assuming the role.",
+							Optional:            true,
+						},
+						"duration": schema.StringAttribute{
+							MarkdownDescription: "The duration the assumed role credentials are valid for, e.g. `1h`. Defaults to 15 minutes.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
 }
 
 func (p *ArchitectProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	cfg, err := config.LoadDefaultConfig(ctx)
+	var data ArchitectProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var loadOpts []func(*config.LoadOptions) error
+
+	if v := data.Region.ValueString(); v != "" {
+		loadOpts = append(loadOpts, config.WithRegion(v))
+	}
+
+	if v := data.Profile.ValueString(); v != "" {
+		loadOpts = append(loadOpts, config.WithSharedConfigProfile(v))
+	}
+
+	if len(data.SharedCredentialsFiles) > 0 {
+		files := make([]string, 0, len(data.SharedCredentialsFiles))
+		for _, f := range data.SharedCredentialsFiles {
+			files = append(files, f.ValueString())
+		}
+		loadOpts = append(loadOpts, config.WithSharedCredentialsFiles(files))
+	}
+
+	if data.AccessKey.ValueString() != "" || data.SecretKey.ValueString() != "" {
+		loadOpts = append(loadOpts, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			data.AccessKey.ValueString(),
+			data.SecretKey.ValueString(),
+			data.Token.ValueString(),
+		)))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, loadOpts...)
 
 	if err != nil {
 		resp.Diagnostics.AddError("AWS configuration error", err.Error())
 		return
 	}
 
+	if len(data.AssumeRole) > 0 {
+		assumeRole := data.AssumeRole[0]
+
+		if assumeRole.RoleARN.ValueString() == "" {
+			resp.Diagnostics.AddError("Invalid assume_role configuration", "role_arn is required when assume_role is set")
+			return
+		}
+
+		stsClient := sts.NewFromConfig(cfg)
+
+		assumeRoleProvider := stscreds.NewAssumeRoleProvider(stsClient, assumeRole.RoleARN.ValueString(), func(o *stscreds.AssumeRoleOptions) {
+			if v := assumeRole.SessionName.ValueString(); v != "" {
+				o.RoleSessionName = v
+			}
+			if v := assumeRole.ExternalID.ValueString(); v != "" {
+				o.ExternalID = aws.String(v)
+			}
+			if v := assumeRole.Duration.ValueString(); v != "" {
+				if d, err := time.ParseDuration(v); err == nil {
+					o.Duration = d
+				}
+			}
+		})
+
+		cfg.Credentials = aws.NewCredentialsCache(assumeRoleProvider)
+	}
+
 	p.orgs = organizations.NewFromConfig(cfg)
+	stsClient := sts.NewFromConfig(cfg)
+
+	defaultTags := map[string]string{}
+	if len(data.DefaultTags) > 0 && !data.DefaultTags[0].Tags.IsNull() {
+		resp.Diagnostics.Append(data.DefaultTags[0].Tags.ElementsAs(ctx, &defaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resourceData := &ArchitectResourceData{
+		Orgs:         p.orgs,
+		STS:          stsClient,
+		DefaultTags:  defaultTags,
+		AccountIndex: p.accountIndex,
+	}
 
 	resp.DataSourceData = p.orgs
-	resp.ResourceData = p.orgs
+	resp.ResourceData = resourceData
+	resp.EphemeralResourceData = resourceData
 }
 
 func (p *ArchitectProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAccountResource,
+		NewOrganizationalUnitResource,
 	}
 }
 
 func (p *ArchitectProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewGovernedAccountEphemeralResource,
+	}
 }
 
 func (p *ArchitectProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewOrganizationDataSource,
+		NewOrganizationalUnitDataSource,
+	}
 }
 
 func (p *ArchitectProvider) Functions(ctx context.Context) []func() function.Function {
@@ -71,7 +265,8 @@ func (p *ArchitectProvider) Functions(ctx context.Context) []func() function.Fun
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &ArchitectProvider{
-			version: version,
+			version:      version,
+			accountIndex: newAccountIndex(),
 		}
 	}
 }
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsFromMap converts a tfsdk map attribute into a plain Go map, treating a
+// null or unknown map as empty.
+func tagsFromMap(ctx context.Context, m types.Map) (map[string]string, diag.Diagnostics) {
+	tags := map[string]string{}
+
+	if m.IsNull() || m.IsUnknown() {
+		return tags, nil
+	}
+
+	diags := m.ElementsAs(ctx, &tags, false)
+	return tags, diags
+}
+
+// mapFromTags converts a plain Go map into a tfsdk map attribute.
+func mapFromTags(ctx context.Context, tags map[string]string) (types.Map, diag.Diagnostics) {
+	return types.MapValueFrom(ctx, types.StringType, tags)
+}
+
+// mergeTags layers resource-level tags on top of provider-level default tags,
+// mirroring the upstream AWS provider's default_tags/tags_all behavior.
+func mergeTags(defaultTags, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaultTags)+len(tags))
+
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// filterDefaultTags removes entries from tagsAll that match a default tag of
+// the same key and value, so that `tags` in state only reflects resource-
+// specific tags rather than inherited defaults.
+func filterDefaultTags(tagsAll, defaultTags map[string]string) map[string]string {
+	filtered := make(map[string]string, len(tagsAll))
+
+	for k, v := range tagsAll {
+		if dv, ok := defaultTags[k]; ok && dv == v {
+			continue
+		}
+		filtered[k] = v
+	}
+
+	return filtered
+}
+
+// listAccountTags returns the current Organizations tags for resourceID.
+func listAccountTags(ctx context.Context, c *organizations.Client, resourceID string) (map[string]string, error) {
+	tags := map[string]string{}
+	var next *string
+
+	for {
+		out, err := c.ListTagsForResource(ctx, &organizations.ListTagsForResourceInput{
+			ResourceId: aws.String(resourceID),
+			NextToken:  next,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range out.Tags {
+			tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		next = out.NextToken
+	}
+
+	return tags, nil
+}
+
+// tagUpdater is the subset of *organizations.Client that updateAccountTags
+// needs, narrowed so the tag-diffing logic can be unit tested without a real
+// Organizations API.
+type tagUpdater interface {
+	TagResource(ctx context.Context, params *organizations.TagResourceInput, optFns ...func(*organizations.Options)) (*organizations.TagResourceOutput, error)
+	UntagResource(ctx context.Context, params *organizations.UntagResourceInput, optFns ...func(*organizations.Options)) (*organizations.UntagResourceOutput, error)
+}
+
+// updateAccountTags reconciles the tags on resourceID from oldTags to
+// newTags, issuing TagResource/UntagResource calls only for the keys that
+// actually changed.
+func updateAccountTags(ctx context.Context, c tagUpdater, resourceID string, oldTags, newTags map[string]string) error {
+	var toRemove []string
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			toRemove = append(toRemove, k)
+		}
+	}
+
+	var toAdd []orgstypes.Tag
+	for k, v := range newTags {
+		if old, ok := oldTags[k]; !ok || old != v {
+			toAdd = append(toAdd, orgstypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+	}
+
+	if len(toRemove) > 0 {
+		if _, err := c.UntagResource(ctx, &organizations.UntagResourceInput{
+			ResourceId: aws.String(resourceID),
+			TagKeys:    toRemove,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(toAdd) > 0 {
+		if _, err := c.TagResource(ctx, &organizations.TagResourceInput{
+			ResourceId: aws.String(resourceID),
+			Tags:       toAdd,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+func TestMergeTags(t *testing.T) {
+	defaultTags := map[string]string{"env": "prod", "team": "platform"}
+	tags := map[string]string{"team": "growth", "owner": "alice"}
+
+	got := mergeTags(defaultTags, tags)
+
+	want := map[string]string{"env": "prod", "team": "growth", "owner": "alice"}
+
+	if len(got) != len(want) {
+		t.Fatalf("mergeTags() = %v, want %v", got, want)
+	}
+
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("mergeTags()[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestFilterDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"env": "prod", "team": "platform"}
+	tagsAll := map[string]string{"env": "prod", "team": "growth", "owner": "alice"}
+
+	got := filterDefaultTags(tagsAll, defaultTags)
+
+	want := map[string]string{"team": "growth", "owner": "alice"}
+
+	if len(got) != len(want) {
+		t.Fatalf("filterDefaultTags() = %v, want %v", got, want)
+	}
+
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("filterDefaultTags()[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestFilterDefaultTags_EmptyResult(t *testing.T) {
+	defaultTags := map[string]string{"env": "prod"}
+	tagsAll := map[string]string{"env": "prod"}
+
+	got := filterDefaultTags(tagsAll, defaultTags)
+
+	if len(got) != 0 {
+		t.Errorf("filterDefaultTags() = %v, want empty map", got)
+	}
+}
+
+// fakeTagClient records TagResource/UntagResource calls so updateAccountTags's
+// add/remove diffing can be asserted without a real Organizations API.
+type fakeTagClient struct {
+	tagged   []orgstypes.Tag
+	untagged []string
+}
+
+func (f *fakeTagClient) TagResource(ctx context.Context, params *organizations.TagResourceInput, optFns ...func(*organizations.Options)) (*organizations.TagResourceOutput, error) {
+	f.tagged = append(f.tagged, params.Tags...)
+	return &organizations.TagResourceOutput{}, nil
+}
+
+func (f *fakeTagClient) UntagResource(ctx context.Context, params *organizations.UntagResourceInput, optFns ...func(*organizations.Options)) (*organizations.UntagResourceOutput, error) {
+	f.untagged = append(f.untagged, params.TagKeys...)
+	return &organizations.UntagResourceOutput{}, nil
+}
+
+func TestUpdateAccountTagsDiffing(t *testing.T) {
+	oldTags := map[string]string{"env": "prod", "owner": "alice", "stale": "yes"}
+	newTags := map[string]string{"env": "staging", "owner": "alice", "team": "growth"}
+
+	client := &fakeTagClient{}
+
+	if err := updateAccountTags(context.Background(), client, "123456789012", oldTags, newTags); err != nil {
+		t.Fatalf("updateAccountTags() error = %v", err)
+	}
+
+	sort.Strings(client.untagged)
+	wantUntagged := []string{"stale"}
+	if len(client.untagged) != len(wantUntagged) || client.untagged[0] != wantUntagged[0] {
+		t.Errorf("untagged keys = %v, want %v", client.untagged, wantUntagged)
+	}
+
+	gotTagged := map[string]string{}
+	for _, tag := range client.tagged {
+		gotTagged[*tag.Key] = *tag.Value
+	}
+
+	wantTagged := map[string]string{"env": "staging", "team": "growth"}
+	if len(gotTagged) != len(wantTagged) {
+		t.Fatalf("tagged = %v, want %v", gotTagged, wantTagged)
+	}
+	for k, v := range wantTagged {
+		if gotTagged[k] != v {
+			t.Errorf("tagged[%q] = %q, want %q", k, gotTagged[k], v)
+		}
+	}
+}
+
+func TestUpdateAccountTagsDiffing_NoChanges(t *testing.T) {
+	tags := map[string]string{"env": "prod"}
+
+	client := &fakeTagClient{}
+
+	if err := updateAccountTags(context.Background(), client, "123456789012", tags, tags); err != nil {
+		t.Fatalf("updateAccountTags() error = %v", err)
+	}
+
+	if len(client.tagged) != 0 || len(client.untagged) != 0 {
+		t.Errorf("expected no TagResource/UntagResource calls, got tagged=%v untagged=%v", client.tagged, client.untagged)
+	}
+}
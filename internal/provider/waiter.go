@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+)
+
+const (
+	waiterBaseDelay     = 2 * time.Second
+	waiterMaxDelay      = 30 * time.Second
+	defaultOrgWaitLimit = 20 * time.Minute
+)
+
+// isRetryableOrgError reports whether err represents a transient condition
+// (throttling or a 5xx response) that is safe to retry.
+func isRetryableOrgError(err error) bool {
+	var throttled *orgstypes.TooManyRequestsException
+	if errors.As(err, &throttled) {
+		return true
+	}
+
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) && respErr.HTTPStatusCode() >= 500 {
+		return true
+	}
+
+	return false
+}
+
+// isTerminalOrgError reports whether err is an AWS Organizations error that
+// will not resolve itself by retrying, such as a concurrent modification or
+// invalid input.
+func isTerminalOrgError(err error) bool {
+	var concurrentMod *orgstypes.ConcurrentModificationException
+	if errors.As(err, &concurrentMod) {
+		return true
+	}
+
+	var finalizing *orgstypes.FinalizingOrganizationException
+	if errors.As(err, &finalizing) {
+		return true
+	}
+
+	var invalidInput *orgstypes.InvalidInputException
+	if errors.As(err, &invalidInput) {
+		return true
+	}
+
+	return false
+}
+
+// backoffWait blocks for an exponentially increasing, jittered delay based on
+// attempt (0-indexed), capped at max, returning early if ctx is cancelled.
+func backoffWait(ctx context.Context, attempt int, base, max time.Duration) error {
+	delay := base * time.Duration(int64(1)<<uint(attempt))
+	if delay > max || delay <= 0 {
+		delay = max
+	}
+
+	jittered := time.Duration(rand.Int63n(int64(delay)))
+
+	timer := time.NewTimer(jittered)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryOrgOperation invokes fn, retrying with exponential backoff and jitter on
+// retryable AWS Organizations errors (throttling, 5xx) until it succeeds,
+// returns a terminal/non-retryable error, or timeout elapses.
+func retryOrgOperation(ctx context.Context, timeout time.Duration, fn func() error) error {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if !isRetryableOrgError(err) {
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after retrying a throttled request: %w", err)
+		}
+
+		if waitErr := backoffWait(ctx, attempt, waiterBaseDelay, waiterMaxDelay); waitErr != nil {
+			return waitErr
+		}
+	}
+}
+
+// waitForAccountCreation polls DescribeCreateAccountStatus until the account
+// creation request succeeds or fails, retrying transient errors with backoff
+// and jitter. It returns the new account ID on success.
+func waitForAccountCreation(ctx context.Context, c *organizations.Client, reqID string, timeout time.Duration) (string, error) {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 0; ; attempt++ {
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("timed out waiting for account creation request %s", reqID)
+		}
+
+		desc, err := c.DescribeCreateAccountStatus(ctx, &organizations.DescribeCreateAccountStatusInput{
+			CreateAccountRequestId: aws.String(reqID),
+		})
+
+		if err != nil {
+			if isTerminalOrgError(err) {
+				return "", err
+			}
+
+			if !isRetryableOrgError(err) {
+				return "", err
+			}
+
+			if waitErr := backoffWait(ctx, attempt, waiterBaseDelay, waiterMaxDelay); waitErr != nil {
+				return "", waitErr
+			}
+
+			continue
+		}
+
+		st := desc.CreateAccountStatus
+
+		if st == nil {
+			if waitErr := backoffWait(ctx, attempt, waiterBaseDelay, waiterMaxDelay); waitErr != nil {
+				return "", waitErr
+			}
+
+			continue
+		}
+
+		switch st.State {
+		case orgstypes.CreateAccountStateSucceeded:
+			return aws.ToString(st.AccountId), nil
+		case orgstypes.CreateAccountStateFailed:
+			return "", fmt.Errorf("account creation failed: %s", st.FailureReason)
+		}
+
+		if waitErr := backoffWait(ctx, attempt, waiterBaseDelay, waiterMaxDelay); waitErr != nil {
+			return "", waitErr
+		}
+	}
+}
+
+// waitForAccountClosure polls DescribeAccount until accountID transitions to
+// PENDING_CLOSURE or SUSPENDED after a CloseAccount call, retrying transient
+// errors with backoff and jitter.
+func waitForAccountClosure(ctx context.Context, c *organizations.Client, accountID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 0; ; attempt++ {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for account %s to close", accountID)
+		}
+
+		desc, err := c.DescribeAccount(ctx, &organizations.DescribeAccountInput{
+			AccountId: aws.String(accountID),
+		})
+
+		if err != nil {
+			if isTerminalOrgError(err) {
+				return err
+			}
+
+			if !isRetryableOrgError(err) {
+				return err
+			}
+
+			if waitErr := backoffWait(ctx, attempt, waiterBaseDelay, waiterMaxDelay); waitErr != nil {
+				return waitErr
+			}
+
+			continue
+		}
+
+		switch desc.Account.Status {
+		case orgstypes.AccountStatusPendingClosure, orgstypes.AccountStatusSuspended:
+			return nil
+		}
+
+		if waitErr := backoffWait(ctx, attempt, waiterBaseDelay, waiterMaxDelay); waitErr != nil {
+			return waitErr
+		}
+	}
+}
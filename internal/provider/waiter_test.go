@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestIsRetryableOrgError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"throttled", &types.TooManyRequestsException{}, true},
+		{"server error", &awshttp.ResponseError{ResponseError: &smithyhttp.ResponseError{Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 503}}}}, true},
+		{"plain error", errors.New("boom"), false},
+		{"nil", nil, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isRetryableOrgError(tc.err); got != tc.want {
+				t.Errorf("isRetryableOrgError(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsTerminalOrgError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"concurrent modification", &types.ConcurrentModificationException{}, true},
+		{"finalizing org", &types.FinalizingOrganizationException{}, true},
+		{"invalid input", &types.InvalidInputException{}, true},
+		{"throttled is not terminal", &types.TooManyRequestsException{}, false},
+		{"plain error", errors.New("boom"), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isTerminalOrgError(tc.err); got != tc.want {
+				t.Errorf("isTerminalOrgError(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBackoffWaitContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := backoffWait(ctx, 5, waiterBaseDelay, waiterMaxDelay)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("backoffWait() with cancelled context error = %v, want context.Canceled", err)
+	}
+}
+
+func TestBackoffWaitReturnsBeforeTimerOnCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- backoffWait(ctx, 10, time.Hour, time.Hour)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("backoffWait() error = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("backoffWait() did not return promptly after context cancellation")
+	}
+}